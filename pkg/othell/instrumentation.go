@@ -0,0 +1,97 @@
+package othell
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/stats"
+)
+
+// HTTPMiddleware wraps next with OTel HTTP server instrumentation for a
+// single, low-cardinality route template (e.g. "/users/{id}", not the raw
+// request path): a span per request carrying an http.route attribute set
+// at span-start time (so WithRouteSampler can key sampling decisions off
+// it), the http.server.request.duration histogram, and - via
+// ExtractTraceSpanID already installed on the default slog handler -
+// automatic log/span correlation for any logging done with the request's
+// context.
+//
+// Wire it per route, e.g.
+//
+//	mux.Handle("/users/{id}", o.HTTPMiddleware("/users/{id}", usersHandler))
+func (o *Othell) HTTPMiddleware(route string, next http.Handler) http.Handler {
+	return otelhttp.NewHandler(
+		next,
+		route,
+		otelhttp.WithTracerProvider(o.TraceProvider),
+		otelhttp.WithMeterProvider(o.MeterProvider),
+		otelhttp.WithSpanOptions(trace.WithAttributes(attribute.String("http.route", route))),
+		otelhttp.WithSpanNameFormatter(func(operation string, r *http.Request) string {
+			return r.Method + " " + route
+		}),
+		o.httpMessageEventsOption(),
+	)
+}
+
+// HTTPTransport wraps base (http.DefaultTransport if nil) with OTel client
+// instrumentation, propagating the globally configured propagator and
+// recording client-side request duration.
+func (o *Othell) HTTPTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return otelhttp.NewTransport(
+		base,
+		otelhttp.WithTracerProvider(o.TraceProvider),
+		otelhttp.WithMeterProvider(o.MeterProvider),
+		o.httpMessageEventsOption(),
+	)
+}
+
+func (o *Othell) httpMessageEventsOption() otelhttp.Option {
+	if o.httpMessageEvents {
+		return otelhttp.WithMessageEvents(otelhttp.ReadEvents, otelhttp.WriteEvents)
+	}
+	return otelhttp.WithMessageEvents()
+}
+
+// ServerStatsHandler returns a gRPC stats.Handler that instruments
+// incoming RPCs (both unary and streaming) using the Tracer/Meter Othell
+// already created. Register it with
+// grpc.NewServer(grpc.StatsHandler(o.ServerStatsHandler())), or use
+// ServerOption directly.
+func (o *Othell) ServerStatsHandler() stats.Handler {
+	return otelgrpc.NewServerHandler(o.grpcOptions()...)
+}
+
+// ClientStatsHandler returns a gRPC stats.Handler that instruments
+// outgoing RPCs (both unary and streaming), propagating the active span
+// onto the call. Register it with
+// grpc.Dial(addr, grpc.WithStatsHandler(o.ClientStatsHandler())), or use
+// DialOption directly.
+func (o *Othell) ClientStatsHandler() stats.Handler {
+	return otelgrpc.NewClientHandler(o.grpcOptions()...)
+}
+
+// ServerOption returns a grpc.ServerOption wiring gRPC server
+// instrumentation, for grpc.NewServer(o.ServerOption()).
+func (o *Othell) ServerOption() grpc.ServerOption {
+	return grpc.StatsHandler(o.ServerStatsHandler())
+}
+
+// DialOption returns a grpc.DialOption wiring gRPC client
+// instrumentation, for grpc.NewClient(addr, o.DialOption()).
+func (o *Othell) DialOption() grpc.DialOption {
+	return grpc.WithStatsHandler(o.ClientStatsHandler())
+}
+
+func (o *Othell) grpcOptions() []otelgrpc.Option {
+	return []otelgrpc.Option{
+		otelgrpc.WithTracerProvider(o.TraceProvider),
+		otelgrpc.WithMeterProvider(o.MeterProvider),
+	}
+}
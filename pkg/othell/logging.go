@@ -0,0 +1,202 @@
+package othell
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"go.opentelemetry.io/contrib/bridges/otelslog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// LogFormat selects how well-known keys and trace correlation attributes
+// are rendered in structured log output.
+type LogFormat int
+
+const (
+	// FormatGCP renames attributes to match Cloud Logging's structured
+	// log format (logging.googleapis.com/trace, severity, ...). This is
+	// the default.
+	FormatGCP LogFormat = iota
+	// FormatOTEL leaves keys as OTel semantic conventions (trace_id,
+	// span_id, trace_flags, severity text unchanged).
+	FormatOTEL
+)
+
+// WithLogFormat selects the attribute formatter applied to log records.
+// Defaults to FormatGCP.
+func WithLogFormat(format LogFormat) Option {
+	return func(o *Othell) {
+		o.logFormat = format
+	}
+}
+
+// initLogging builds the slog handler chain: a formatting handler (GCP or
+// OTEL attribute names) fanned out to an OTLP logs bridge, with
+// ExtractTraceSpanID wrapped around the outside so every record gets trace
+// correlation attributes regardless of which formatter/sink is active.
+//
+// The OTLP bridge is built unconditionally and from the same resolved
+// destination as initTracer/initMeter (WithOTLPExporter/WithCollectorEndpoint,
+// falling back to autoexport's OTEL_*_EXPORTER env vars), so logs, metrics,
+// and traces all leave via the same collector. WithGCPExporter applies to
+// traces and metrics only; with it set, logs still go to that OTLP or
+// autoexport destination.
+func (o *Othell) initLogging() error {
+	ctx := context.Background()
+
+	replacer := gcpReplacer
+	if o.logFormat == FormatOTEL {
+		replacer = otelReplacer
+	}
+
+	handler := slog.Handler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{ReplaceAttr: replacer}))
+
+	logExporter, err := o.newLogExporter(ctx)
+	if err != nil {
+		return fmt.Errorf("othell: creating OTLP log exporter: %w", err)
+	}
+
+	o.LoggerProvider = sdklog.NewLoggerProvider(
+		sdklog.WithProcessor(sdklog.NewBatchProcessor(logExporter)),
+		sdklog.WithResource(o.Resource),
+	)
+
+	bridgeHandler := otelslog.NewHandler(o.name, otelslog.WithLoggerProvider(o.LoggerProvider))
+	handler = newFanoutHandler(handler, bridgeHandler)
+
+	slog.SetDefault(slog.New(ExtractTraceSpanID(handler)))
+	return nil
+}
+
+// ExtractTraceSpanID wraps handler so that any log record emitted with a
+// context carrying an active span gets trace_id/span_id/trace_flags
+// attributes added at log time, without ever storing a logger in the
+// context. Error-level records additionally mark the span as failed and
+// record a log event on it, so error logs automatically annotate their
+// spans.
+func ExtractTraceSpanID(handler slog.Handler) slog.Handler {
+	return &traceContextHandler{Handler: handler}
+}
+
+// traceContextHandler is a slog.Handler which adds attributes from the span
+// in context, and annotates the span when the record is an error.
+type traceContextHandler struct {
+	slog.Handler
+}
+
+func (t *traceContextHandler) Handle(ctx context.Context, record slog.Record) error {
+	span := trace.SpanFromContext(ctx)
+	sc := span.SpanContext()
+	if sc.IsValid() {
+		record.AddAttrs(
+			slog.String("trace_id", sc.TraceID().String()),
+			slog.String("span_id", sc.SpanID().String()),
+			slog.Bool("trace_flags", sc.TraceFlags().IsSampled()),
+		)
+
+		if record.Level >= slog.LevelError {
+			span.SetStatus(codes.Error, record.Message)
+			span.AddEvent("log", trace.WithAttributes(
+				attribute.String("log.severity", record.Level.String()),
+				attribute.String("log.message", record.Message),
+			))
+		}
+	}
+	return t.Handler.Handle(ctx, record)
+}
+
+func (t *traceContextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &traceContextHandler{Handler: t.Handler.WithAttrs(attrs)}
+}
+
+func (t *traceContextHandler) WithGroup(name string) slog.Handler {
+	return &traceContextHandler{Handler: t.Handler.WithGroup(name)}
+}
+
+// gcpReplacer renames attribute keys to match Cloud Logging structured log
+// format, described in
+// https://cloud.google.com/logging/docs/structured-logging#special-payload-fields
+func gcpReplacer(groups []string, a slog.Attr) slog.Attr {
+	switch a.Key {
+	case slog.LevelKey:
+		a.Key = "severity"
+		// Map slog.Level string values to Cloud Logging LogSeverity
+		// https://cloud.google.com/logging/docs/reference/v2/rest/v2/LogEntry#LogSeverity
+		if level, ok := a.Value.Any().(slog.Level); ok && level == slog.LevelWarn {
+			a.Value = slog.StringValue("WARNING")
+		}
+	case slog.TimeKey:
+		a.Key = "timestamp"
+	case slog.MessageKey:
+		a.Key = "message"
+	case "trace_id":
+		a.Key = "logging.googleapis.com/trace"
+		a.Value = slog.StringValue(fmt.Sprintf("projects/%s/traces/%s", projectID, a.Value.String()))
+	case "span_id":
+		a.Key = "logging.googleapis.com/spanId"
+	case "trace_flags":
+		a.Key = "logging.googleapis.com/trace_sampled"
+	}
+	return a
+}
+
+// otelReplacer leaves keys as-is; used when WithLogFormat(FormatOTEL) is
+// set so raw OTel semconv attribute names pass through unchanged.
+func otelReplacer(groups []string, a slog.Attr) slog.Attr {
+	return a
+}
+
+// fanoutHandler fans a single log record out to multiple handlers, e.g. a
+// local stdout sink and an OTLP logs bridge, so logs can leave via the same
+// collector as traces and metrics while still printing locally.
+type fanoutHandler struct {
+	handlers []slog.Handler
+}
+
+func newFanoutHandler(handlers ...slog.Handler) slog.Handler {
+	return &fanoutHandler{handlers: handlers}
+}
+
+func (f *fanoutHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, h := range f.handlers {
+		if h.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *fanoutHandler) Handle(ctx context.Context, record slog.Record) error {
+	var errs []error
+	for _, h := range f.handlers {
+		if !h.Enabled(ctx, record.Level) {
+			continue
+		}
+		if err := h.Handle(ctx, record.Clone()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (f *fanoutHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	next := make([]slog.Handler, len(f.handlers))
+	for i, h := range f.handlers {
+		next[i] = h.WithAttrs(attrs)
+	}
+	return &fanoutHandler{handlers: next}
+}
+
+func (f *fanoutHandler) WithGroup(name string) slog.Handler {
+	next := make([]slog.Handler, len(f.handlers))
+	for i, h := range f.handlers {
+		next[i] = h.WithGroup(name)
+	}
+	return &fanoutHandler{handlers: next}
+}
@@ -0,0 +1,141 @@
+package othell
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestGCPReplacer(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      slog.Attr
+		wantKey string
+		wantVal any
+	}{
+		{name: "level info renamed to severity", in: slog.Any(slog.LevelKey, slog.LevelInfo), wantKey: "severity", wantVal: slog.AnyValue(slog.LevelInfo)},
+		{name: "level warn maps to WARNING text", in: slog.Any(slog.LevelKey, slog.LevelWarn), wantKey: "severity", wantVal: slog.StringValue("WARNING")},
+		{name: "time renamed to timestamp", in: slog.String(slog.TimeKey, "now"), wantKey: "timestamp", wantVal: slog.StringValue("now")},
+		{name: "message renamed", in: slog.String(slog.MessageKey, "hi"), wantKey: "message", wantVal: slog.StringValue("hi")},
+		{name: "span_id renamed", in: slog.String("span_id", "abc"), wantKey: "logging.googleapis.com/spanId", wantVal: slog.StringValue("abc")},
+		{name: "trace_flags renamed", in: slog.Bool("trace_flags", true), wantKey: "logging.googleapis.com/trace_sampled", wantVal: slog.BoolValue(true)},
+		{name: "unrelated key untouched", in: slog.String("user_id", "42"), wantKey: "user_id", wantVal: slog.StringValue("42")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := gcpReplacer(nil, tt.in)
+			if got.Key != tt.wantKey {
+				t.Errorf("Key = %q, want %q", got.Key, tt.wantKey)
+			}
+			if got.Value.String() != tt.wantVal.(slog.Value).String() {
+				t.Errorf("Value = %v, want %v", got.Value, tt.wantVal)
+			}
+		})
+	}
+}
+
+func TestGCPReplacerTraceID(t *testing.T) {
+	projectID = "my-project"
+	got := gcpReplacer(nil, slog.String("trace_id", "deadbeef"))
+	if got.Key != "logging.googleapis.com/trace" {
+		t.Fatalf("Key = %q, want logging.googleapis.com/trace", got.Key)
+	}
+	want := "projects/my-project/traces/deadbeef"
+	if got.Value.String() != want {
+		t.Errorf("Value = %q, want %q", got.Value.String(), want)
+	}
+}
+
+func TestOTELReplacerPassesThrough(t *testing.T) {
+	in := slog.String("trace_id", "deadbeef")
+	got := otelReplacer(nil, in)
+	if got.Key != in.Key || got.Value.String() != in.Value.String() {
+		t.Errorf("otelReplacer modified attribute: got %v, want unchanged %v", got, in)
+	}
+}
+
+// recordingHandler is a minimal slog.Handler that records every record it
+// receives, optionally failing.
+type recordingHandler struct {
+	enabled bool
+	records *[]string
+	err     error
+}
+
+func (h *recordingHandler) Enabled(context.Context, slog.Level) bool { return h.enabled }
+
+func (h *recordingHandler) Handle(_ context.Context, r slog.Record) error {
+	*h.records = append(*h.records, r.Message)
+	return h.err
+}
+
+func (h *recordingHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+func (h *recordingHandler) WithGroup(string) slog.Handler      { return h }
+
+func TestFanoutHandlerHandlesAllEnabledHandlers(t *testing.T) {
+	var aRecords, bRecords []string
+	a := &recordingHandler{enabled: true, records: &aRecords}
+	b := &recordingHandler{enabled: true, records: &bRecords}
+
+	h := newFanoutHandler(a, b)
+	rec := slog.NewRecord(time.Now(), slog.LevelInfo, "hello", 0)
+	if err := h.Handle(context.Background(), rec); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+
+	if len(aRecords) != 1 || aRecords[0] != "hello" {
+		t.Errorf("handler a records = %v, want [hello]", aRecords)
+	}
+	if len(bRecords) != 1 || bRecords[0] != "hello" {
+		t.Errorf("handler b records = %v, want [hello]", bRecords)
+	}
+}
+
+func TestFanoutHandlerSkipsDisabledHandlers(t *testing.T) {
+	var aRecords, bRecords []string
+	a := &recordingHandler{enabled: true, records: &aRecords}
+	b := &recordingHandler{enabled: false, records: &bRecords}
+
+	h := newFanoutHandler(a, b)
+	rec := slog.NewRecord(time.Now(), slog.LevelInfo, "hello", 0)
+	if err := h.Handle(context.Background(), rec); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+
+	if len(aRecords) != 1 {
+		t.Errorf("handler a records = %v, want 1 record", aRecords)
+	}
+	if len(bRecords) != 0 {
+		t.Errorf("handler b records = %v, want no records", bRecords)
+	}
+}
+
+func TestFanoutHandlerJoinsErrors(t *testing.T) {
+	errA := errors.New("a failed")
+	errB := errors.New("b failed")
+	a := &recordingHandler{enabled: true, records: &[]string{}, err: errA}
+	b := &recordingHandler{enabled: true, records: &[]string{}, err: errB}
+
+	h := newFanoutHandler(a, b)
+	rec := slog.NewRecord(time.Now(), slog.LevelInfo, "hello", 0)
+	err := h.Handle(context.Background(), rec)
+	if !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Errorf("Handle() error = %v, want to wrap both %v and %v", err, errA, errB)
+	}
+}
+
+func TestFanoutHandlerEnabled(t *testing.T) {
+	var records []string
+	allDisabled := newFanoutHandler(&recordingHandler{enabled: false, records: &records}, &recordingHandler{enabled: false, records: &records})
+	if allDisabled.Enabled(context.Background(), slog.LevelInfo) {
+		t.Error("Enabled() = true, want false when all handlers are disabled")
+	}
+
+	oneEnabled := newFanoutHandler(&recordingHandler{enabled: false, records: &records}, &recordingHandler{enabled: true, records: &records})
+	if !oneEnabled.Enabled(context.Background(), slog.LevelInfo) {
+		t.Error("Enabled() = false, want true when at least one handler is enabled")
+	}
+}
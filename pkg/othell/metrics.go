@@ -0,0 +1,77 @@
+package othell
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/host"
+	"go.opentelemetry.io/contrib/instrumentation/runtime"
+)
+
+// WithRuntimeMetrics starts Go runtime instrumentation (heap/GC stats,
+// goroutine count, ...) against the MeterProvider Othell creates, sampling
+// runtime.MemStats at most every interval. Collection stops when Shutdown
+// is called.
+func WithRuntimeMetrics(interval time.Duration) Option {
+	return func(o *Othell) {
+		o.runtimeMetricsInterval = interval
+	}
+}
+
+// WithHostMetrics starts host instrumentation (process CPU time, memory,
+// network, ...) against the MeterProvider Othell creates. Collection stops
+// when Shutdown is called.
+func WithHostMetrics() Option {
+	return func(o *Othell) {
+		o.hostMetrics = true
+	}
+}
+
+func (o *Othell) startInstrumentation() error {
+	if o.runtimeMetricsInterval > 0 {
+		if err := runtime.Start(
+			runtime.WithMeterProvider(o.MeterProvider),
+			runtime.WithMinimumReadMemStatsInterval(o.runtimeMetricsInterval),
+		); err != nil {
+			return fmt.Errorf("othell: starting runtime metrics: %w", err)
+		}
+	}
+
+	if o.hostMetrics {
+		if err := host.Start(host.WithMeterProvider(o.MeterProvider)); err != nil {
+			return fmt.Errorf("othell: starting host metrics: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Shutdown flushes and closes the tracer provider, meter provider, and
+// logger provider (if one was started), joining any errors encountered.
+// Call it once on process exit to drain buffered spans, metrics and logs;
+// this also stops any runtime/host metric collection started via
+// WithRuntimeMetrics/WithHostMetrics, since it reads through the same
+// MeterProvider.
+func (o *Othell) Shutdown(ctx context.Context) error {
+	var errs []error
+
+	if o.TraceProvider != nil {
+		if err := o.TraceProvider.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("shutting down trace provider: %w", err))
+		}
+	}
+	if o.MeterProvider != nil {
+		if err := o.MeterProvider.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("shutting down meter provider: %w", err))
+		}
+	}
+	if o.LoggerProvider != nil {
+		if err := o.LoggerProvider.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("shutting down logger provider: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -4,15 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log/slog"
-	"os"
+	"time"
 
 	"cloud.google.com/go/compute/metadata"
-	"go.opentelemetry.io/contrib/exporters/autoexport"
 	"go.opentelemetry.io/contrib/propagators/autoprop"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
 	"go.opentelemetry.io/otel/metric"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -23,10 +22,23 @@ type Othell struct {
 	CollectorEndpoint string
 	TraceProvider     *sdktrace.TracerProvider
 	MeterProvider     *sdkmetric.MeterProvider
+	LoggerProvider    *sdklog.LoggerProvider
 	Tracer            trace.Tracer
 	Meter             metric.Meter
 	Resource          *resource.Resource
 	DebugTracer       bool
+
+	name                   string
+	logFormat              LogFormat
+	httpMessageEvents      bool
+	sampler                sdktrace.Sampler
+	routeSamplerRates      map[string]float64
+	useGCPExporter         bool
+	resourceErr            error
+	runtimeMetricsInterval time.Duration
+	hostMetrics            bool
+	otlpConfig             *OTLPConfig
+	extraSpanProcessors    []sdktrace.SpanProcessor
 }
 
 type Option func(*Othell)
@@ -49,13 +61,22 @@ func getProjectID() string {
 // New creates a new Othell instance with the provided options.
 // The name is important for disambiguating the service or module.
 func New(name string, opts ...Option) (*Othell, error) {
-	o := &Othell{}
+	o := &Othell{name: name}
 	for _, opt := range opts {
 		opt(o)
 	}
+	o.resolveSampler()
+
+	if o.Resource == nil && o.resourceErr != nil {
+		return nil, fmt.Errorf("othell: auto-detecting resource: %w", o.resourceErr)
+	}
 
 	if o.Resource == nil {
-		return nil, fmt.Errorf("resource is required. Use WithResoure()")
+		res, err := autoResource(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("othell: auto-detecting resource: %w", err)
+		}
+		o.Resource = res
 	}
 
 	if err := o.initTracer(); err != nil {
@@ -66,6 +87,10 @@ func New(name string, opts ...Option) (*Othell, error) {
 		return o, err
 	}
 
+	if err := o.startInstrumentation(); err != nil {
+		return o, err
+	}
+
 	if err := o.initLogging(); err != nil {
 		return o, err
 	}
@@ -75,6 +100,11 @@ func New(name string, opts ...Option) (*Othell, error) {
 	return o, nil
 }
 
+// WithCollectorEndpoint points traces and metrics at an OTLP/gRPC collector
+// at endpoint, insecure by default. It's a shorthand for
+// WithOTLPExporter(OTLPConfig{Endpoint: endpoint, Protocol: GRPC, Insecure:
+// true}); use WithOTLPExporter directly for TLS, headers, HTTP/protobuf, or
+// compression.
 func WithCollectorEndpoint(endpoint string) Option {
 	return func(o *Othell) {
 		o.CollectorEndpoint = endpoint
@@ -93,21 +123,34 @@ func WithDebugTracer() Option {
 	}
 }
 
+// WithHTTPMessageEvents enables request/response size span events on spans
+// created by HTTPMiddleware and HTTPTransport.
+func WithHTTPMessageEvents() Option {
+	return func(o *Othell) {
+		o.httpMessageEvents = true
+	}
+}
+
 func (o *Othell) initTracer() error {
 	ctx := context.Background()
 
 	// Set global propagators (W3C and baggage).
 	otel.SetTextMapPropagator(autoprop.NewTextMapPropagator())
 
-	otelExporter, err := autoexport.NewSpanExporter(ctx)
+	otelExporter, err := o.newSpanExporter(ctx)
 	if err != nil {
 		return err
 	}
 
 	var consoleExporter *stdouttrace.Exporter
 
+	sampler := o.sampler
+	if sampler == nil {
+		sampler = sdktrace.AlwaysSample()
+	}
+
 	traceProviderOptions := []sdktrace.TracerProviderOption{}
-	traceProviderOptions = append(traceProviderOptions, sdktrace.WithSampler(sdktrace.AlwaysSample()))
+	traceProviderOptions = append(traceProviderOptions, sdktrace.WithSampler(sampler))
 	traceProviderOptions = append(traceProviderOptions, sdktrace.WithSpanProcessor(sdktrace.NewBatchSpanProcessor(otelExporter)))
 	traceProviderOptions = append(traceProviderOptions, sdktrace.WithResource(o.Resource))
 	if o.DebugTracer {
@@ -122,6 +165,10 @@ func (o *Othell) initTracer() error {
 		traceProviderOptions = append(traceProviderOptions, sdktrace.WithSpanProcessor(sdktrace.NewSimpleSpanProcessor(consoleExporter)))
 	}
 
+	for _, sp := range o.extraSpanProcessors {
+		traceProviderOptions = append(traceProviderOptions, sdktrace.WithSpanProcessor(sp))
+	}
+
 	o.TraceProvider = sdktrace.NewTracerProvider(
 		traceProviderOptions...,
 	)
@@ -135,73 +182,16 @@ func (o *Othell) initTracer() error {
 func (o *Othell) initMeter() error {
 	ctx := context.Background()
 
-	meter, err := autoexport.NewMetricReader(ctx)
+	reader, err := o.newMetricReader(ctx)
 	if err != nil {
 		return errors.Join(err)
 	}
 
 	o.MeterProvider = sdkmetric.NewMeterProvider(
-		sdkmetric.WithReader(meter),
+		sdkmetric.WithReader(reader),
 		sdkmetric.WithResource(o.Resource),
 	)
 
 	otel.SetMeterProvider(o.MeterProvider)
 	return nil
 }
-
-func (o *Othell) initLogging() error {
-	jsonHandler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{ReplaceAttr: replacer})
-	instrumentedHandler := handlerWithSpanContext(jsonHandler)
-	slog.SetDefault(slog.New(instrumentedHandler))
-	return nil
-}
-
-// This code is lifted from Google documentation
-
-func handlerWithSpanContext(handler slog.Handler) *spanContextLogHandler {
-	return &spanContextLogHandler{Handler: handler}
-}
-
-// spanContextLogHandler is a slog.Handler which adds attributes from the
-// span context.
-type spanContextLogHandler struct {
-	slog.Handler
-}
-
-// Handle overrides slog.Handler's Handle method. This adds attributes from the
-// span context to the slog.Record.
-func (t *spanContextLogHandler) Handle(ctx context.Context, record slog.Record) error {
-	// Get the SpanContext from the context.
-	if s := trace.SpanContextFromContext(ctx); s.IsValid() {
-		// Add trace context attributes following Cloud Logging structured log format described
-		// in https://cloud.google.com/logging/docs/structured-logging#special-payload-fields
-		record.AddAttrs(
-			slog.Any("logging.googleapis.com/trace", fmt.Sprintf("projects/%s/traces/%s", projectID, s.TraceID())),
-		)
-		record.AddAttrs(
-			slog.Any("logging.googleapis.com/spanId", s.SpanID()),
-		)
-		record.AddAttrs(
-			slog.Bool("logging.googleapis.com/trace_sampled", s.TraceFlags().IsSampled()),
-		)
-	}
-	return t.Handler.Handle(ctx, record)
-}
-
-func replacer(groups []string, a slog.Attr) slog.Attr {
-	// Rename attribute keys to match Cloud Logging structured log format
-	switch a.Key {
-	case slog.LevelKey:
-		a.Key = "severity"
-		// Map slog.Level string values to Cloud Logging LogSeverity
-		// https://cloud.google.com/logging/docs/reference/v2/rest/v2/LogEntry#LogSeverity
-		if level := a.Value.Any().(slog.Level); level == slog.LevelWarn {
-			a.Value = slog.StringValue("WARNING")
-		}
-	case slog.TimeKey:
-		a.Key = "timestamp"
-	case slog.MessageKey:
-		a.Key = "message"
-	}
-	return a
-}
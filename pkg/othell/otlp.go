@@ -0,0 +1,191 @@
+package othell
+
+import (
+	"context"
+	"crypto/tls"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc/credentials"
+)
+
+// Protocol selects the wire protocol used to talk to an OTLP endpoint.
+type Protocol int
+
+const (
+	// GRPC sends OTLP over gRPC (the default).
+	GRPC Protocol = iota
+	// HTTPProtobuf sends OTLP/HTTP with a binary protobuf body.
+	HTTPProtobuf
+)
+
+// OTLPConfig configures an explicit OTLP exporter, bypassing autoexport's
+// OTEL_*_EXPORTER environment-variable discovery.
+type OTLPConfig struct {
+	Endpoint    string
+	Protocol    Protocol
+	Insecure    bool
+	Headers     map[string]string
+	TLS         *tls.Config
+	Compression string // e.g. "gzip"; empty disables compression.
+	Timeout     time.Duration
+}
+
+// WithOTLPExporter configures the trace and metric exporters to talk
+// directly to cfg.Endpoint over the chosen protocol, instead of relying on
+// autoexport's environment variables. WithCollectorEndpoint is a shorthand
+// for the common insecure-gRPC case and forwards into this same config.
+func WithOTLPExporter(cfg OTLPConfig) Option {
+	return func(o *Othell) {
+		o.otlpConfig = &cfg
+	}
+}
+
+// resolvedOTLPConfig returns the explicit OTLPConfig if one was set via
+// WithOTLPExporter, or a default gRPC config derived from
+// WithCollectorEndpoint, or nil if neither was configured.
+func (o *Othell) resolvedOTLPConfig() *OTLPConfig {
+	if o.otlpConfig != nil {
+		return o.otlpConfig
+	}
+	if o.CollectorEndpoint != "" {
+		return &OTLPConfig{Endpoint: o.CollectorEndpoint, Protocol: GRPC, Insecure: true}
+	}
+	return nil
+}
+
+func (cfg *OTLPConfig) newSpanExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	if cfg.Protocol == HTTPProtobuf {
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		if cfg.TLS != nil {
+			opts = append(opts, otlptracehttp.WithTLSClientConfig(cfg.TLS))
+		}
+		if len(cfg.Headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(cfg.Headers))
+		}
+		if cfg.Compression == "gzip" {
+			opts = append(opts, otlptracehttp.WithCompression(otlptracehttp.GzipCompression))
+		}
+		if cfg.Timeout > 0 {
+			opts = append(opts, otlptracehttp.WithTimeout(cfg.Timeout))
+		}
+		return otlptracehttp.New(ctx, opts...)
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	if cfg.TLS != nil {
+		opts = append(opts, otlptracegrpc.WithTLSCredentials(credentials.NewTLS(cfg.TLS)))
+	}
+	if len(cfg.Headers) > 0 {
+		opts = append(opts, otlptracegrpc.WithHeaders(cfg.Headers))
+	}
+	if cfg.Compression != "" {
+		opts = append(opts, otlptracegrpc.WithCompressor(cfg.Compression))
+	}
+	if cfg.Timeout > 0 {
+		opts = append(opts, otlptracegrpc.WithTimeout(cfg.Timeout))
+	}
+	return otlptracegrpc.New(ctx, opts...)
+}
+
+func (cfg *OTLPConfig) newMetricReader(ctx context.Context) (sdkmetric.Reader, error) {
+	if cfg.Protocol == HTTPProtobuf {
+		opts := []otlpmetrichttp.Option{otlpmetrichttp.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlpmetrichttp.WithInsecure())
+		}
+		if cfg.TLS != nil {
+			opts = append(opts, otlpmetrichttp.WithTLSClientConfig(cfg.TLS))
+		}
+		if len(cfg.Headers) > 0 {
+			opts = append(opts, otlpmetrichttp.WithHeaders(cfg.Headers))
+		}
+		if cfg.Compression == "gzip" {
+			opts = append(opts, otlpmetrichttp.WithCompression(otlpmetrichttp.GzipCompression))
+		}
+		if cfg.Timeout > 0 {
+			opts = append(opts, otlpmetrichttp.WithTimeout(cfg.Timeout))
+		}
+		exporter, err := otlpmetrichttp.New(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return sdkmetric.NewPeriodicReader(exporter), nil
+	}
+
+	opts := []otlpmetricgrpc.Option{otlpmetricgrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlpmetricgrpc.WithInsecure())
+	}
+	if cfg.TLS != nil {
+		opts = append(opts, otlpmetricgrpc.WithTLSCredentials(credentials.NewTLS(cfg.TLS)))
+	}
+	if len(cfg.Headers) > 0 {
+		opts = append(opts, otlpmetricgrpc.WithHeaders(cfg.Headers))
+	}
+	if cfg.Compression != "" {
+		opts = append(opts, otlpmetricgrpc.WithCompressor(cfg.Compression))
+	}
+	if cfg.Timeout > 0 {
+		opts = append(opts, otlpmetricgrpc.WithTimeout(cfg.Timeout))
+	}
+	exporter, err := otlpmetricgrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return sdkmetric.NewPeriodicReader(exporter), nil
+}
+
+func (cfg *OTLPConfig) newLogExporter(ctx context.Context) (sdklog.Exporter, error) {
+	if cfg.Protocol == HTTPProtobuf {
+		opts := []otlploghttp.Option{otlploghttp.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlploghttp.WithInsecure())
+		}
+		if cfg.TLS != nil {
+			opts = append(opts, otlploghttp.WithTLSClientConfig(cfg.TLS))
+		}
+		if len(cfg.Headers) > 0 {
+			opts = append(opts, otlploghttp.WithHeaders(cfg.Headers))
+		}
+		if cfg.Compression == "gzip" {
+			opts = append(opts, otlploghttp.WithCompression(otlploghttp.GzipCompression))
+		}
+		if cfg.Timeout > 0 {
+			opts = append(opts, otlploghttp.WithTimeout(cfg.Timeout))
+		}
+		return otlploghttp.New(ctx, opts...)
+	}
+
+	opts := []otlploggrpc.Option{otlploggrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlploggrpc.WithInsecure())
+	}
+	if cfg.TLS != nil {
+		opts = append(opts, otlploggrpc.WithTLSCredentials(credentials.NewTLS(cfg.TLS)))
+	}
+	if len(cfg.Headers) > 0 {
+		opts = append(opts, otlploggrpc.WithHeaders(cfg.Headers))
+	}
+	if cfg.Compression != "" {
+		opts = append(opts, otlploggrpc.WithCompressor(cfg.Compression))
+	}
+	if cfg.Timeout > 0 {
+		opts = append(opts, otlploggrpc.WithTimeout(cfg.Timeout))
+	}
+	return otlploggrpc.New(ctx, opts...)
+}
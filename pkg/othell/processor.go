@@ -0,0 +1,63 @@
+package othell
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// WithSpanProcessor registers an additional sdktrace.SpanProcessor on the
+// TracerProvider, e.g. for enriching spans with deployment metadata,
+// scrubbing PII, or forwarding to a tail-sampling sidecar. Processors run
+// in the order they were added, after the batch exporter processor (and
+// the console processor, if WithDebugTracer is set).
+func WithSpanProcessor(sp sdktrace.SpanProcessor) Option {
+	return func(o *Othell) {
+		o.extraSpanProcessors = append(o.extraSpanProcessors, sp)
+	}
+}
+
+// WithBaggageAttributes registers a BaggageSpanProcessor that copies the
+// named W3C baggage members onto every span as attributes, so business
+// identifiers (tenant, request-id, ...) injected upstream via baggage are
+// visible on downstream spans without every call site doing it by hand.
+func WithBaggageAttributes(keys ...string) Option {
+	return func(o *Othell) {
+		o.extraSpanProcessors = append(o.extraSpanProcessors, NewBaggageSpanProcessor(keys...))
+	}
+}
+
+// BaggageSpanProcessor copies the listed baggage members from the starting
+// span's context onto the span as attributes.
+type BaggageSpanProcessor struct {
+	Keys []string
+}
+
+// NewBaggageSpanProcessor returns a BaggageSpanProcessor that copies the
+// given baggage member keys onto spans as attributes on start.
+func NewBaggageSpanProcessor(keys ...string) *BaggageSpanProcessor {
+	return &BaggageSpanProcessor{Keys: keys}
+}
+
+func (p *BaggageSpanProcessor) OnStart(ctx context.Context, span sdktrace.ReadWriteSpan) {
+	bag := baggage.FromContext(ctx)
+	for _, key := range p.Keys {
+		member := bag.Member(key)
+		if member.Key() == "" {
+			continue
+		}
+		span.SetAttributes(attribute.String(key, member.Value()))
+	}
+}
+
+func (p *BaggageSpanProcessor) OnEnd(sdktrace.ReadOnlySpan) {}
+
+func (p *BaggageSpanProcessor) Shutdown(context.Context) error {
+	return nil
+}
+
+func (p *BaggageSpanProcessor) ForceFlush(context.Context) error {
+	return nil
+}
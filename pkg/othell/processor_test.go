@@ -0,0 +1,106 @@
+package othell
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/baggage"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestBaggageSpanProcessorOnStart(t *testing.T) {
+	tests := []struct {
+		name           string
+		keys           []string
+		baggageMembers map[string]string
+		wantAttrs      map[string]string
+	}{
+		{
+			name:           "copies requested member",
+			keys:           []string{"tenant"},
+			baggageMembers: map[string]string{"tenant": "acme"},
+			wantAttrs:      map[string]string{"tenant": "acme"},
+		},
+		{
+			name:           "copies only requested members, ignores others",
+			keys:           []string{"tenant"},
+			baggageMembers: map[string]string{"tenant": "acme", "request-id": "abc123"},
+			wantAttrs:      map[string]string{"tenant": "acme"},
+		},
+		{
+			name:           "missing member is skipped",
+			keys:           []string{"tenant", "missing"},
+			baggageMembers: map[string]string{"tenant": "acme"},
+			wantAttrs:      map[string]string{"tenant": "acme"},
+		},
+		{
+			name:           "no baggage in context",
+			keys:           []string{"tenant"},
+			baggageMembers: nil,
+			wantAttrs:      map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			tp := sdktrace.NewTracerProvider(
+				sdktrace.WithSyncer(exporter),
+				sdktrace.WithSpanProcessor(NewBaggageSpanProcessor(tt.keys...)),
+			)
+			defer tp.Shutdown(context.Background())
+
+			ctx := context.Background()
+			if len(tt.baggageMembers) > 0 {
+				var members []baggage.Member
+				for k, v := range tt.baggageMembers {
+					m, err := baggage.NewMember(k, v)
+					if err != nil {
+						t.Fatalf("baggage.NewMember(%q, %q) error = %v", k, v, err)
+					}
+					members = append(members, m)
+				}
+				bag, err := baggage.New(members...)
+				if err != nil {
+					t.Fatalf("baggage.New() error = %v", err)
+				}
+				ctx = baggage.ContextWithBaggage(ctx, bag)
+			}
+
+			_, span := tp.Tracer("test").Start(ctx, "span")
+			span.End()
+
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("got %d exported spans, want 1", len(spans))
+			}
+
+			got := map[string]string{}
+			for _, kv := range spans[0].Attributes {
+				got[string(kv.Key)] = kv.Value.AsString()
+			}
+
+			for key, want := range tt.wantAttrs {
+				if got[key] != want {
+					t.Errorf("attribute %q = %q, want %q (all attrs: %v)", key, got[key], want, got)
+				}
+			}
+			if len(got) != len(tt.wantAttrs) {
+				t.Errorf("got %d attributes %v, want %d %v", len(got), got, len(tt.wantAttrs), tt.wantAttrs)
+			}
+		})
+	}
+}
+
+func TestBaggageSpanProcessorLifecycleNoops(t *testing.T) {
+	p := NewBaggageSpanProcessor("tenant")
+	if err := p.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown() error = %v, want nil", err)
+	}
+	if err := p.ForceFlush(context.Background()); err != nil {
+		t.Errorf("ForceFlush() error = %v, want nil", err)
+	}
+	// OnEnd is a no-op; just make sure it doesn't panic.
+	p.OnEnd(nil)
+}
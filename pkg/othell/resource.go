@@ -0,0 +1,85 @@
+package othell
+
+import (
+	"context"
+
+	mexporter "github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/metric"
+	texporter "github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/trace"
+	"go.opentelemetry.io/contrib/detectors/gcp"
+	"go.opentelemetry.io/contrib/exporters/autoexport"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// WithAutoResource composes resource.Default() with GCP resource detectors
+// (GCE, GKE, Cloud Run, Cloud Functions) and the OTel env-based detector, so
+// callers don't need to hand-build a *resource.Resource. This is also what
+// New() falls back to when no resource option is given at all.
+func WithAutoResource(ctx context.Context) Option {
+	return func(o *Othell) {
+		res, err := autoResource(ctx)
+		if err != nil {
+			o.resourceErr = err
+			return
+		}
+		o.Resource = res
+	}
+}
+
+func autoResource(ctx context.Context) (*resource.Resource, error) {
+	detected, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithOS(),
+		resource.WithProcess(),
+		resource.WithHost(),
+		resource.WithDetectors(gcp.NewDetector()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resource.Merge(resource.Default(), detected)
+}
+
+// WithGCPExporter wires the opentelemetry-operations-go trace and metric
+// exporters directly to Cloud Trace and Cloud Monitoring, bypassing a
+// collector. Use this instead of OTLP/autoexport when running somewhere
+// with direct access to the GCP telemetry APIs (e.g. GCE, GKE, Cloud Run).
+func WithGCPExporter() Option {
+	return func(o *Othell) {
+		o.useGCPExporter = true
+	}
+}
+
+func (o *Othell) newSpanExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	if o.useGCPExporter {
+		return texporter.New()
+	}
+	if cfg := o.resolvedOTLPConfig(); cfg != nil {
+		return cfg.newSpanExporter(ctx)
+	}
+	return autoexport.NewSpanExporter(ctx)
+}
+
+func (o *Othell) newMetricReader(ctx context.Context) (sdkmetric.Reader, error) {
+	if o.useGCPExporter {
+		exporter, err := mexporter.New()
+		if err != nil {
+			return nil, err
+		}
+		return sdkmetric.NewPeriodicReader(exporter), nil
+	}
+	if cfg := o.resolvedOTLPConfig(); cfg != nil {
+		return cfg.newMetricReader(ctx)
+	}
+	return autoexport.NewMetricReader(ctx)
+}
+
+func (o *Othell) newLogExporter(ctx context.Context) (sdklog.Exporter, error) {
+	if cfg := o.resolvedOTLPConfig(); cfg != nil {
+		return cfg.newLogExporter(ctx)
+	}
+	return autoexport.NewLogExporter(ctx)
+}
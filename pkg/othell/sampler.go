@@ -0,0 +1,120 @@
+package othell
+
+import (
+	"os"
+	"strconv"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// WithSampler sets the trace sampler used by the TracerProvider. Defaults
+// to sdktrace.AlwaysSample(), which is unsafe for production traffic;
+// prefer WithRatioSampler, WithSamplerFromEnv, or WithRouteSampler.
+func WithSampler(sampler sdktrace.Sampler) Option {
+	return func(o *Othell) {
+		o.sampler = sampler
+	}
+}
+
+// WithRatioSampler samples a fraction of traces, respecting the parent's
+// sampling decision when there is one. fraction is clamped to [0,1] by the
+// underlying sdktrace.TraceIDRatioBased sampler.
+func WithRatioSampler(fraction float64) Option {
+	return WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(fraction)))
+}
+
+// WithSamplerFromEnv honors the standard OTEL_TRACES_SAMPLER /
+// OTEL_TRACES_SAMPLER_ARG environment variables
+// (https://opentelemetry.io/docs/specs/otel/configuration/sdk-environment-variables/#general-sdk-configuration),
+// falling back to ParentBased(AlwaysSample()) when unset or unrecognized.
+func WithSamplerFromEnv() Option {
+	return WithSampler(samplerFromEnv())
+}
+
+func samplerFromEnv() sdktrace.Sampler {
+	arg := os.Getenv("OTEL_TRACES_SAMPLER_ARG")
+	ratio := func() float64 {
+		f, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return 1.0
+		}
+		return f
+	}
+
+	switch os.Getenv("OTEL_TRACES_SAMPLER") {
+	case "always_on":
+		return sdktrace.AlwaysSample()
+	case "always_off":
+		return sdktrace.NeverSample()
+	case "traceidratio":
+		return sdktrace.TraceIDRatioBased(ratio())
+	case "parentbased_always_off":
+		return sdktrace.ParentBased(sdktrace.NeverSample())
+	case "parentbased_traceidratio":
+		return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio()))
+	default:
+		return sdktrace.ParentBased(sdktrace.AlwaysSample())
+	}
+}
+
+// WithRouteSampler applies per-endpoint sampling rates, keyed by the
+// span's http.route or rpc.method attribute, e.g.
+//
+//	othell.WithRouteSampler(map[string]float64{
+//	    "/healthz":                     0,
+//	    "/grpc.health.v1.Health/Check": 0,
+//	})
+//
+// to drop health-check spans entirely while keeping the rest of the
+// business traffic sampled by the fallback (whatever sampler was set via
+// WithSampler/WithRatioSampler/WithSamplerFromEnv, or
+// ParentBased(AlwaysSample()) if none was).
+//
+// The fallback is resolved from the final sampler after all options have
+// been applied, not from whatever WithSampler call happened to run before
+// this one - so WithRouteSampler and WithRatioSampler/WithSampler/
+// WithSamplerFromEnv can be passed to New in either order.
+func WithRouteSampler(rates map[string]float64) Option {
+	return func(o *Othell) {
+		o.routeSamplerRates = rates
+	}
+}
+
+// resolveSampler composes the route sampler (if WithRouteSampler was used)
+// around whatever base sampler the other sampler options resolved to. It
+// runs once in New, after all options have been applied.
+func (o *Othell) resolveSampler() {
+	if o.routeSamplerRates == nil {
+		return
+	}
+
+	fallback := o.sampler
+	if fallback == nil {
+		fallback = sdktrace.ParentBased(sdktrace.AlwaysSample())
+	}
+	o.sampler = &routeSampler{rates: o.routeSamplerRates, fallback: fallback}
+}
+
+// routeSampler dispatches to a per-route TraceIDRatioBased sampler based on
+// the http.route/rpc.method attribute passed to ShouldSample, falling back
+// to fallback for attributes not present in rates.
+type routeSampler struct {
+	rates    map[string]float64
+	fallback sdktrace.Sampler
+}
+
+func (s *routeSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	for _, kv := range p.Attributes {
+		switch kv.Key {
+		case "http.route", "rpc.method":
+			if rate, ok := s.rates[kv.Value.AsString()]; ok {
+				return sdktrace.TraceIDRatioBased(rate).ShouldSample(p)
+			}
+		}
+	}
+	return s.fallback.ShouldSample(p)
+}
+
+func (s *routeSampler) Description() string {
+	return "RouteSampler"
+}
@@ -0,0 +1,97 @@
+package othell
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestRouteSamplerShouldSample(t *testing.T) {
+	tests := []struct {
+		name       string
+		rates      map[string]float64
+		attrs      []attribute.KeyValue
+		wantSample bool
+	}{
+		{
+			name:       "matched http.route dropped",
+			rates:      map[string]float64{"GET /healthz": 0},
+			attrs:      []attribute.KeyValue{attribute.String("http.route", "GET /healthz")},
+			wantSample: false,
+		},
+		{
+			name:       "matched http.route kept",
+			rates:      map[string]float64{"GET /healthz": 1},
+			attrs:      []attribute.KeyValue{attribute.String("http.route", "GET /healthz")},
+			wantSample: true,
+		},
+		{
+			name:       "matched rpc.method dropped",
+			rates:      map[string]float64{"/grpc.health.v1.Health/Check": 0},
+			attrs:      []attribute.KeyValue{attribute.String("rpc.method", "/grpc.health.v1.Health/Check")},
+			wantSample: false,
+		},
+		{
+			name:       "unmatched route falls back to AlwaysSample",
+			rates:      map[string]float64{"GET /healthz": 0},
+			attrs:      []attribute.KeyValue{attribute.String("http.route", "GET /users/{id}")},
+			wantSample: true,
+		},
+		{
+			name:       "no route/method attribute falls back to AlwaysSample",
+			rates:      map[string]float64{"GET /healthz": 0},
+			attrs:      nil,
+			wantSample: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &routeSampler{rates: tt.rates, fallback: sdktrace.AlwaysSample()}
+			result := s.ShouldSample(sdktrace.SamplingParameters{Attributes: tt.attrs})
+
+			sampled := result.Decision != sdktrace.Drop
+			if sampled != tt.wantSample {
+				t.Errorf("ShouldSample() sampled = %v, want %v (decision=%v)", sampled, tt.wantSample, result.Decision)
+			}
+		})
+	}
+}
+
+func TestRouteSamplerDescription(t *testing.T) {
+	s := &routeSampler{}
+	if got := s.Description(); got != "RouteSampler" {
+		t.Errorf("Description() = %q, want %q", got, "RouteSampler")
+	}
+}
+
+func TestSamplerFromEnv(t *testing.T) {
+	tests := []struct {
+		name       string
+		samplerEnv string
+		argEnv     string
+		want       string
+	}{
+		{name: "always_on", samplerEnv: "always_on", want: "AlwaysOnSampler"},
+		{name: "always_off", samplerEnv: "always_off", want: "AlwaysOffSampler"},
+		{name: "traceidratio", samplerEnv: "traceidratio", argEnv: "0.5", want: "TraceIDRatioBased{0.5}"},
+		// TraceIDRatioBased(1.0) returns sdktrace.AlwaysSample().
+		{name: "traceidratio invalid arg defaults to 1.0", samplerEnv: "traceidratio", argEnv: "not-a-number", want: "AlwaysOnSampler"},
+		{name: "parentbased_always_off", samplerEnv: "parentbased_always_off", want: "ParentBased{root:AlwaysOffSampler,remoteParentSampled:AlwaysOnSampler,remoteParentNotSampled:AlwaysOffSampler,localParentSampled:AlwaysOnSampler,localParentNotSampled:AlwaysOffSampler}"},
+		{name: "parentbased_traceidratio", samplerEnv: "parentbased_traceidratio", argEnv: "0.25", want: "ParentBased{root:TraceIDRatioBased{0.25},remoteParentSampled:AlwaysOnSampler,remoteParentNotSampled:AlwaysOffSampler,localParentSampled:AlwaysOnSampler,localParentNotSampled:AlwaysOffSampler}"},
+		{name: "unset defaults to parentbased always_on", samplerEnv: "", want: "ParentBased{root:AlwaysOnSampler,remoteParentSampled:AlwaysOnSampler,remoteParentNotSampled:AlwaysOffSampler,localParentSampled:AlwaysOnSampler,localParentNotSampled:AlwaysOffSampler}"},
+		{name: "unrecognized value defaults to parentbased always_on", samplerEnv: "bogus", want: "ParentBased{root:AlwaysOnSampler,remoteParentSampled:AlwaysOnSampler,remoteParentNotSampled:AlwaysOffSampler,localParentSampled:AlwaysOnSampler,localParentNotSampled:AlwaysOffSampler}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("OTEL_TRACES_SAMPLER", tt.samplerEnv)
+			t.Setenv("OTEL_TRACES_SAMPLER_ARG", tt.argEnv)
+
+			if got := samplerFromEnv().Description(); got != tt.want {
+				t.Errorf("samplerFromEnv().Description() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}